@@ -6,30 +6,173 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/jagdpruefer/parser/pkg/analysis"
 	"github.com/jagdpruefer/parser/pkg/pdf"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "search" {
+		runSearchCommand(os.Args[2:])
+		return
+	}
+
 	// Command-line flags
 	inputPDF := flag.String("input", "", "Path to the PDF file to parse (or use -batch for multiple files)")
 	outputJSON := flag.String("output", "", "Path to output JSON file (optional, defaults to questions.json)")
 	batch := flag.Bool("batch", false, "Process all sg*.pdf files in current directory")
 	verbose := flag.Bool("verbose", false, "Enable verbose output")
 	dir := flag.String("dir", ".", "Directory to search for PDF files (used with -batch)")
+	category := flag.String("category", "", "Only keep questions whose category contains this substring")
+	idRange := flag.String("id-range", "", "Only keep questions with an ID in MIN-MAX (e.g. 100-200)")
+	onlyWithCorrect := flag.Bool("only-with-correct", false, "Only keep questions that have at least one option marked correct")
+	excludePattern := flag.String("exclude-pattern", "", "Drop questions whose text matches this regexp")
+	lint := flag.Bool("lint", false, "Run the built-in analyzers over the parsed catalog and report diagnostics")
+	lintJSON := flag.Bool("lint-json", false, "Print -lint diagnostics as JSON instead of human-readable text")
+	extractor := flag.String("extractor", "pdftotext", "Text extraction backend: pdftotext or gopdf")
+	layout := flag.Bool("layout", false, "Pass -layout to pdftotext, preserving column layout (pdftotext backend only)")
 
 	flag.Parse()
 
+	selectOpts, err := buildSelectOptions(*category, *idRange, *excludePattern, *onlyWithCorrect)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	extractorOpt, err := buildExtractorOption(*extractor, *layout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	selectOpts = append(selectOpts, extractorOpt)
+
 	if *batch {
-		processBatch(*dir, *outputJSON, *verbose)
+		processBatch(*dir, *outputJSON, *verbose, selectOpts, *lint, *lintJSON)
+	} else {
+		processSingle(*inputPDF, *outputJSON, *verbose, selectOpts, *lint, *lintJSON)
+	}
+}
+
+// runLint runs the built-in analyzers over catalog, prints the diagnostics
+// they report, and returns true if any of them are errors.
+func runLint(catalog *pdf.QuestionCatalog, jsonOutput bool) bool {
+	diags := analysis.NewDriver(analysis.Analyzers...).Run(catalog)
+
+	if jsonOutput {
+		data, err := json.MarshalIndent(diags, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error marshaling diagnostics: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
 	} else {
-		processSingle(*inputPDF, *outputJSON, *verbose)
+		for _, d := range diags {
+			fmt.Printf("Q%d [%s:%s] %s\n", d.QuestionID, d.Analyzer, d.Severity, d.Message)
+		}
+		fmt.Printf("%d diagnostics\n", len(diags))
+	}
+
+	hasError := false
+	for _, d := range diags {
+		if d.Severity == analysis.SeverityError {
+			hasError = true
+			break
+		}
 	}
+	return hasError
 }
 
-func processSingle(inputPDF, outputJSON string, verbose bool) {
+// buildExtractorOption turns -extractor/-layout into the ParserOption that
+// selects a TextExtractor backend, letting -extractor=gopdf run without
+// requiring the pdftotext binary to be installed.
+func buildExtractorOption(name string, layout bool) (pdf.ParserOption, error) {
+	switch name {
+	case "pdftotext":
+		return pdf.WithLayoutMode(layout), nil
+	case "gopdf":
+		return pdf.WithExtractor(pdf.NewGoPDFExtractor()), nil
+	default:
+		return nil, fmt.Errorf("unknown -extractor %q, expected pdftotext or gopdf", name)
+	}
+}
+
+// buildSelectOptions turns the filter-related CLI flags into pdf.ParserOptions,
+// so the same filters apply uniformly across single-file and batch parsing.
+func buildSelectOptions(category, idRange, excludePattern string, onlyWithCorrect bool) ([]pdf.ParserOption, error) {
+	var opts []pdf.ParserOption
+
+	if category != "" {
+		opts = append(opts, pdf.WithSelectCategoryFunc(func(c pdf.Category) bool {
+			for _, segment := range c.Path {
+				if strings.Contains(segment, category) {
+					return true
+				}
+			}
+			return false
+		}))
+	}
+
+	var minID, maxID int
+	hasIDRange := false
+	if idRange != "" {
+		parts := strings.SplitN(idRange, "-", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid -id-range %q, expected MIN-MAX", idRange)
+		}
+		var err error
+		minID, err = strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid -id-range %q: %w", idRange, err)
+		}
+		maxID, err = strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid -id-range %q: %w", idRange, err)
+		}
+		hasIDRange = true
+	}
+
+	var excludeRe *regexp.Regexp
+	if excludePattern != "" {
+		var err error
+		excludeRe, err = regexp.Compile(excludePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -exclude-pattern: %w", err)
+		}
+	}
+
+	if hasIDRange || onlyWithCorrect || excludeRe != nil {
+		opts = append(opts, pdf.WithSelectFunc(func(q *pdf.Question) bool {
+			if hasIDRange && (q.ID < minID || q.ID > maxID) {
+				return false
+			}
+			if onlyWithCorrect {
+				hasCorrect := false
+				for _, opt := range q.Options {
+					if opt.Correct {
+						hasCorrect = true
+						break
+					}
+				}
+				if !hasCorrect {
+					return false
+				}
+			}
+			if excludeRe != nil && excludeRe.MatchString(q.Text) {
+				return false
+			}
+			return true
+		}))
+	}
+
+	return opts, nil
+}
+
+func processSingle(inputPDF, outputJSON string, verbose bool, selectOpts []pdf.ParserOption, lint, lintJSON bool) {
 	// Validate input
 	if inputPDF == "" {
 		fmt.Fprintf(os.Stderr, "Error: input file required\n")
@@ -53,7 +196,7 @@ func processSingle(inputPDF, outputJSON string, verbose bool) {
 	}
 
 	// Parse the PDF
-	catalog, err := pdf.ParseFile(inputPDF)
+	catalog, err := pdf.ParseFile(inputPDF, selectOpts...)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error parsing PDF: %v\n", err)
 		os.Exit(1)
@@ -67,9 +210,13 @@ func processSingle(inputPDF, outputJSON string, verbose bool) {
 	}
 
 	writeCatalog(catalog, outputJSON, verbose)
+
+	if lint && runLint(catalog, lintJSON) {
+		os.Exit(1)
+	}
 }
 
-func processBatch(dir, outputJSON string, verbose bool) {
+func processBatch(dir, outputJSON string, verbose bool, selectOpts []pdf.ParserOption, lint, lintJSON bool) {
 	// Find all sg*.pdf files
 	entries, err := os.ReadDir(dir)
 	if err != nil {
@@ -136,7 +283,7 @@ func processBatch(dir, outputJSON string, verbose bool) {
 			fmt.Printf("\nProcessing SG%d: %s\n", sgFile.sgNum, sgFile.path)
 		}
 
-		catalog, err := pdf.ParseFile(sgFile.path)
+		catalog, err := pdf.ParseFile(sgFile.path, selectOpts...)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error parsing %s: %v\n", sgFile.path, err)
 			continue
@@ -163,6 +310,10 @@ func processBatch(dir, outputJSON string, verbose bool) {
 	}
 
 	writeCatalog(mergedCatalog, outputJSON, verbose)
+
+	if lint && runLint(mergedCatalog, lintJSON) {
+		os.Exit(1)
+	}
 }
 
 func writeCatalog(catalog *pdf.QuestionCatalog, outputPath string, verbose bool) {
@@ -198,12 +349,23 @@ func writeCatalog(catalog *pdf.QuestionCatalog, outputPath string, verbose bool)
 		fmt.Printf("  Total Correct Answers: %d\n", correctCount)
 		fmt.Printf("  Title: %s\n", catalog.Title)
 
+		sgCounts := catalog.CategoriesIndex()
+		sgKeys := make([]string, 0, len(sgCounts))
+		for sg := range sgCounts {
+			sgKeys = append(sgKeys, sg)
+		}
+		sort.Strings(sgKeys)
+		fmt.Printf("  Questions per Sachgebiet:\n")
+		for _, sg := range sgKeys {
+			fmt.Printf("    %s: %d\n", sg, len(sgCounts[sg]))
+		}
+
 		// Show first question as sample
 		if len(catalog.Questions) > 0 {
 			fmt.Printf("\n--- Sample Question ---\n")
 			q := catalog.Questions[0]
 			fmt.Printf("Q%d: %s\n", q.ID, q.Text)
-			fmt.Printf("Category: %s\n", q.Category)
+			fmt.Printf("Category: %s\n", strings.Join(q.Category.Path, " > "))
 			fmt.Printf("Options:\n")
 			for _, opt := range q.Options {
 				correct := ""
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jagdpruefer/parser/pkg/pdf"
+	"github.com/jagdpruefer/parser/pkg/search"
+)
+
+// runSearchCommand implements `parser search <query>`: it loads a parsed
+// catalog (from JSON, or by reparsing PDFs) and prints fuzzy matches ranked
+// the way fzf ranks results.
+func runSearchCommand(args []string) {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	jsonPath := fs.String("json", "questions.json", "Path to a previously parsed questions.json to search")
+	inputPDF := fs.String("input", "", "Parse this PDF instead of loading -json")
+	fieldsFlag := fs.String("field", "text,options,category", "Comma-separated fields to search: text,options,category")
+	correctOnly := fs.Bool("correct-only", false, "Only match questions with at least one correct option")
+	sortLimit := fs.Int("sort", search.DefaultSortLimit, "Cap on how many candidates get detailed ranking; beyond this, results keep scan order")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fmt.Fprintf(os.Stderr, "Usage: parser search [flags] <query>\n")
+		fs.PrintDefaults()
+		os.Exit(1)
+	}
+	query := strings.Join(fs.Args(), " ")
+
+	var catalog *pdf.QuestionCatalog
+	var err error
+	if *inputPDF != "" {
+		catalog, err = pdf.ParseFile(*inputPDF)
+	} else {
+		catalog, err = loadCatalogJSON(*jsonPath)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading catalog: %v\n", err)
+		os.Exit(1)
+	}
+
+	fields, err := parseFields(*fieldsFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	matches := search.Search(catalog, query, search.Options{
+		Fields:      fields,
+		CorrectOnly: *correctOnly,
+		SortLimit:   *sortLimit,
+	})
+
+	for _, m := range matches {
+		fmt.Printf("Q%d [%s] %s\n", m.Question.ID, m.Field, highlightMatch(m.Text, m.Start, m.End))
+	}
+	fmt.Printf("%d matches\n", len(matches))
+}
+
+func loadCatalogJSON(path string) (*pdf.QuestionCatalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	var catalog pdf.QuestionCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &catalog, nil
+}
+
+func parseFields(raw string) ([]search.Field, error) {
+	var fields []search.Field
+	for _, part := range strings.Split(raw, ",") {
+		switch search.Field(strings.TrimSpace(part)) {
+		case search.FieldText:
+			fields = append(fields, search.FieldText)
+		case search.FieldOptions:
+			fields = append(fields, search.FieldOptions)
+		case search.FieldCategory:
+			fields = append(fields, search.FieldCategory)
+		default:
+			return nil, fmt.Errorf("unknown -field %q, expected text, options, or category", part)
+		}
+	}
+	return fields, nil
+}
+
+// highlightMatch wraps the matched window of text in ANSI bold so it stands
+// out in terminal output.
+func highlightMatch(text string, start, end int) string {
+	runes := []rune(text)
+	if start < 0 || end > len(runes) || start >= end {
+		return text
+	}
+	return string(runes[:start]) + "\x1b[1m" + string(runes[start:end]) + "\x1b[0m" + string(runes[end:])
+}
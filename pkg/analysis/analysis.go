@@ -0,0 +1,102 @@
+// Package analysis runs a registered set of Analyzers over a parsed
+// QuestionCatalog and collects the Diagnostics they report, in the spirit of
+// gopls' analysis framework (see x/tools/go/analysis).
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jagdpruefer/parser/pkg/models"
+)
+
+// Severity classifies how serious a Diagnostic is.
+type Severity int
+
+const (
+	// SeverityWarning flags something worth a human's attention but that
+	// doesn't necessarily indicate bad data.
+	SeverityWarning Severity = iota
+	// SeverityError flags data that is almost certainly wrong.
+	SeverityError
+)
+
+// String renders the severity the way -lint's human-readable output does.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON renders the severity as its String() form ("error"/"warning")
+// so -lint-json output is self-explanatory without consulting this package's
+// source for what the underlying int means.
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON parses the String() form back into a Severity, the inverse
+// of MarshalJSON.
+func (s *Severity) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	switch str {
+	case "error":
+		*s = SeverityError
+	case "warning":
+		*s = SeverityWarning
+	default:
+		return fmt.Errorf("unknown severity %q", str)
+	}
+	return nil
+}
+
+// Diagnostic is a single finding reported by an Analyzer against one question.
+type Diagnostic struct {
+	// Analyzer is the Name of the Analyzer that reported this Diagnostic.
+	// The driver fills this in; Analyzer.Run doesn't need to set it.
+	Analyzer   string   `json:"analyzer"`
+	QuestionID int      `json:"questionId"`
+	Severity   Severity `json:"severity"`
+	Message    string   `json:"message"`
+}
+
+// Analyzer checks a QuestionCatalog for a specific class of problem.
+type Analyzer struct {
+	// Name identifies the analyzer, e.g. "correctcount".
+	Name string
+	// Doc is a one-line description of what the analyzer checks for.
+	Doc string
+	// Run inspects catalog and returns any Diagnostics it finds.
+	Run func(catalog *models.QuestionCatalog) []Diagnostic
+}
+
+// Driver runs a fixed set of Analyzers over a catalog.
+type Driver struct {
+	analyzers []*Analyzer
+}
+
+// NewDriver returns a Driver that runs the given analyzers, in order.
+func NewDriver(analyzers ...*Analyzer) *Driver {
+	return &Driver{analyzers: analyzers}
+}
+
+// Run executes every registered analyzer against catalog and returns their
+// combined diagnostics, tagged with the analyzer that produced each one.
+func (d *Driver) Run(catalog *models.QuestionCatalog) []Diagnostic {
+	var diags []Diagnostic
+	for _, a := range d.analyzers {
+		for _, diag := range a.Run(catalog) {
+			diag.Analyzer = a.Name
+			diags = append(diags, diag)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,43 @@
+package analysis
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSeverityJSON(t *testing.T) {
+	tests := []struct {
+		severity Severity
+		want     string
+	}{
+		{SeverityError, `"error"`},
+		{SeverityWarning, `"warning"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			data, err := json.Marshal(tt.severity)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Marshal(%v) = %s, want %s", tt.severity, data, tt.want)
+			}
+
+			var got Severity
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if got != tt.severity {
+				t.Errorf("Unmarshal(%s) = %v, want %v", data, got, tt.severity)
+			}
+		})
+	}
+}
+
+func TestSeverityUnmarshalUnknown(t *testing.T) {
+	var s Severity
+	if err := json.Unmarshal([]byte(`"critical"`), &s); err == nil {
+		t.Fatal("Unmarshal of an unknown severity string should fail")
+	}
+}
@@ -0,0 +1,156 @@
+package analysis
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jagdpruefer/parser/pkg/models"
+)
+
+// minTextLen is the threshold below which question or option text is
+// flagged as suspiciously short, a common sign of a parse split.
+const minTextLen = 10
+
+// Analyzers is the built-in set run by `parser -lint`.
+var Analyzers = []*Analyzer{
+	CorrectCountAnalyzer,
+	OptionSequenceAnalyzer,
+	ShortTextAnalyzer,
+	DuplicateTextAnalyzer,
+	TruncatedTextAnalyzer,
+}
+
+// CorrectCountAnalyzer reports questions with zero or more than one option
+// marked correct.
+var CorrectCountAnalyzer = &Analyzer{
+	Name: "correctcount",
+	Doc:  "reports questions with zero or multiple options marked correct",
+	Run: func(catalog *models.QuestionCatalog) []Diagnostic {
+		var diags []Diagnostic
+		for _, q := range catalog.Questions {
+			correct := 0
+			for _, opt := range q.Options {
+				if opt.Correct {
+					correct++
+				}
+			}
+			switch {
+			case correct == 0:
+				diags = append(diags, Diagnostic{
+					QuestionID: q.ID,
+					Severity:   SeverityError,
+					Message:    "no option marked correct",
+				})
+			case correct > 1:
+				diags = append(diags, Diagnostic{
+					QuestionID: q.ID,
+					Severity:   SeverityError,
+					Message:    fmt.Sprintf("%d options marked correct, expected exactly 1", correct),
+				})
+			}
+		}
+		return diags
+	},
+}
+
+// OptionSequenceAnalyzer reports options whose letters are out of the
+// expected a, b, c, ... sequence or have a gap (e.g. a, b, d).
+var OptionSequenceAnalyzer = &Analyzer{
+	Name: "optionsequence",
+	Doc:  "reports options with letters out of a..f sequence or with gaps",
+	Run: func(catalog *models.QuestionCatalog) []Diagnostic {
+		var diags []Diagnostic
+		for _, q := range catalog.Questions {
+			for i, opt := range q.Options {
+				want := string(rune('a' + i))
+				if opt.Letter != want {
+					diags = append(diags, Diagnostic{
+						QuestionID: q.ID,
+						Severity:   SeverityWarning,
+						Message:    fmt.Sprintf("option %d has letter %q, expected %q", i+1, opt.Letter, want),
+					})
+				}
+			}
+		}
+		return diags
+	},
+}
+
+// ShortTextAnalyzer reports question or option text shorter than
+// minTextLen characters.
+var ShortTextAnalyzer = &Analyzer{
+	Name: "shorttext",
+	Doc:  "reports question or option text shorter than 10 characters",
+	Run: func(catalog *models.QuestionCatalog) []Diagnostic {
+		var diags []Diagnostic
+		for _, q := range catalog.Questions {
+			if n := utf8.RuneCountInString(q.Text); n < minTextLen {
+				diags = append(diags, Diagnostic{
+					QuestionID: q.ID,
+					Severity:   SeverityWarning,
+					Message:    fmt.Sprintf("question text suspiciously short (%d chars)", n),
+				})
+			}
+			for _, opt := range q.Options {
+				if n := utf8.RuneCountInString(opt.Text); n < minTextLen {
+					diags = append(diags, Diagnostic{
+						QuestionID: q.ID,
+						Severity:   SeverityWarning,
+						Message:    fmt.Sprintf("option %s text suspiciously short (%d chars)", opt.Letter, n),
+					})
+				}
+			}
+		}
+		return diags
+	},
+}
+
+// DuplicateTextAnalyzer reports questions whose text is duplicated
+// elsewhere in the catalog, e.g. the same question appearing under two SGs.
+var DuplicateTextAnalyzer = &Analyzer{
+	Name: "duplicatetext",
+	Doc:  "reports questions whose text is duplicated across the catalog",
+	Run: func(catalog *models.QuestionCatalog) []Diagnostic {
+		var diags []Diagnostic
+		seenAt := make(map[string]int) // question text -> first question ID seen
+		for _, q := range catalog.Questions {
+			if firstID, ok := seenAt[q.Text]; ok {
+				diags = append(diags, Diagnostic{
+					QuestionID: q.ID,
+					Severity:   SeverityWarning,
+					Message:    fmt.Sprintf("duplicate of question %d", firstID),
+				})
+				continue
+			}
+			seenAt[q.Text] = q.ID
+		}
+		return diags
+	},
+}
+
+// danglingTrailer matches a question ending on a word that can't stand on
+// its own, the telltale sign of the "X a)" trailing-content cleanup in
+// pdf.Parser having eaten part of the real sentence.
+var danglingTrailer = regexp.MustCompile(`(?i)\b(und|oder|der|die|das|dem|den|ist|sind|bei|mit|für|im|in|zu|auf|vom|von)$`)
+
+// TruncatedTextAnalyzer reports questions whose text looks like it lost
+// trailing content because the stray "X a)" cleanup ran too aggressively.
+var TruncatedTextAnalyzer = &Analyzer{
+	Name: "truncatedtext",
+	Doc:  "reports questions that appear to have lost trailing content to the \"X a)\" cleanup",
+	Run: func(catalog *models.QuestionCatalog) []Diagnostic {
+		var diags []Diagnostic
+		for _, q := range catalog.Questions {
+			if danglingTrailer.MatchString(strings.TrimSpace(q.Text)) {
+				diags = append(diags, Diagnostic{
+					QuestionID: q.ID,
+					Severity:   SeverityWarning,
+					Message:    "question text ends mid-sentence, possibly truncated by cleanup",
+				})
+			}
+		}
+		return diags
+	},
+}
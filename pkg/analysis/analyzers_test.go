@@ -0,0 +1,205 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/jagdpruefer/parser/pkg/models"
+)
+
+func question(id int, text string, options ...models.Option) models.Question {
+	return models.Question{ID: id, Text: text, Options: options}
+}
+
+func option(letter, text string, correct bool) models.Option {
+	return models.Option{Letter: letter, Text: text, Correct: correct}
+}
+
+func TestCorrectCountAnalyzer(t *testing.T) {
+	tests := []struct {
+		name      string
+		questions []models.Question
+		wantIDs   []int
+	}{
+		{
+			name: "exactly one correct is fine",
+			questions: []models.Question{
+				question(1, "a question long enough", option("a", "option text long enough", true), option("b", "option text long enough", false)),
+			},
+			wantIDs: nil,
+		},
+		{
+			name: "zero correct is flagged",
+			questions: []models.Question{
+				question(1, "a question long enough", option("a", "option text long enough", false)),
+			},
+			wantIDs: []int{1},
+		},
+		{
+			name: "multiple correct is flagged",
+			questions: []models.Question{
+				question(1, "a question long enough", option("a", "option text long enough", true), option("b", "option text long enough", true)),
+			},
+			wantIDs: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := &models.QuestionCatalog{Questions: tt.questions}
+			diags := CorrectCountAnalyzer.Run(catalog)
+			assertQuestionIDs(t, diags, tt.wantIDs)
+			for _, d := range diags {
+				if d.Severity != SeverityError {
+					t.Errorf("diagnostic for Q%d has severity %v, want SeverityError", d.QuestionID, d.Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestOptionSequenceAnalyzer(t *testing.T) {
+	tests := []struct {
+		name      string
+		questions []models.Question
+		wantIDs   []int
+	}{
+		{
+			name: "a, b, c sequence is fine",
+			questions: []models.Question{
+				question(1, "text", option("a", "text", true), option("b", "text", false), option("c", "text", false)),
+			},
+			wantIDs: nil,
+		},
+		{
+			name: "gap in the sequence is flagged",
+			questions: []models.Question{
+				question(1, "text", option("a", "text", true), option("d", "text", false)),
+			},
+			wantIDs: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := &models.QuestionCatalog{Questions: tt.questions}
+			diags := OptionSequenceAnalyzer.Run(catalog)
+			assertQuestionIDs(t, diags, tt.wantIDs)
+		})
+	}
+}
+
+func TestShortTextAnalyzer(t *testing.T) {
+	tests := []struct {
+		name      string
+		questions []models.Question
+		wantIDs   []int
+	}{
+		{
+			name: "long question and option text is fine",
+			questions: []models.Question{
+				question(1, "a question long enough to pass", option("a", "an option long enough to pass", true)),
+			},
+			wantIDs: nil,
+		},
+		{
+			name: "short question text is flagged",
+			questions: []models.Question{
+				question(1, "too short", option("a", "an option long enough to pass", true)),
+			},
+			wantIDs: []int{1},
+		},
+		{
+			name: "short option text is flagged",
+			questions: []models.Question{
+				question(1, "a question long enough to pass", option("a", "short", true)),
+			},
+			wantIDs: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := &models.QuestionCatalog{Questions: tt.questions}
+			diags := ShortTextAnalyzer.Run(catalog)
+			assertQuestionIDs(t, diags, tt.wantIDs)
+		})
+	}
+}
+
+func TestDuplicateTextAnalyzer(t *testing.T) {
+	tests := []struct {
+		name      string
+		questions []models.Question
+		wantIDs   []int
+	}{
+		{
+			name: "distinct text is fine",
+			questions: []models.Question{
+				question(1, "first question text"),
+				question(2, "second question text"),
+			},
+			wantIDs: nil,
+		},
+		{
+			name: "duplicate text is flagged on the later question",
+			questions: []models.Question{
+				question(1, "same question text"),
+				question(2, "same question text"),
+			},
+			wantIDs: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := &models.QuestionCatalog{Questions: tt.questions}
+			diags := DuplicateTextAnalyzer.Run(catalog)
+			assertQuestionIDs(t, diags, tt.wantIDs)
+		})
+	}
+}
+
+func TestTruncatedTextAnalyzer(t *testing.T) {
+	tests := []struct {
+		name      string
+		questions []models.Question
+		wantIDs   []int
+	}{
+		{
+			name: "complete sentence is fine",
+			questions: []models.Question{
+				question(1, "Welche Aussage zur Anscheinswaffe ist richtig?"),
+			},
+			wantIDs: nil,
+		},
+		{
+			name: "dangling conjunction is flagged",
+			questions: []models.Question{
+				question(1, "Welche Waffe ist verboten und"),
+			},
+			wantIDs: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := &models.QuestionCatalog{Questions: tt.questions}
+			diags := TruncatedTextAnalyzer.Run(catalog)
+			assertQuestionIDs(t, diags, tt.wantIDs)
+		})
+	}
+}
+
+// assertQuestionIDs checks that diags reports exactly the question IDs in
+// want, in order, regardless of message text.
+func assertQuestionIDs(t *testing.T, diags []Diagnostic, want []int) {
+	t.Helper()
+	if len(diags) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d: %+v", len(diags), len(want), diags)
+	}
+	for i, d := range diags {
+		if d.QuestionID != want[i] {
+			t.Errorf("diagnostic %d is for Q%d, want Q%d", i, d.QuestionID, want[i])
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package models
 
+import "fmt"
+
 // Option represents a single answer option
 type Option struct {
 	Letter  string `json:"letter"`  // a, b, c, d, e, f
@@ -7,21 +9,42 @@ type Option struct {
 	Correct bool   `json:"correct"` // Whether this is a correct answer
 }
 
+// Category is a resolved position in the Sachgebiet (subject area)
+// hierarchy. SubSG and the deeper Path entries are empty for a question
+// that only has a top-level Sachgebiet.
+type Category struct {
+	SG    int      `json:"sg"`              // Top-level Sachgebiet number
+	SubSG string   `json:"subSg,omitempty"` // Sub-section number, e.g. "1.1"
+	Title string   `json:"title"`           // Title of the deepest resolved level
+	Path  []string `json:"path"`            // Breadcrumb from the Sachgebiet down to this level
+}
+
 // Question represents a single exam question
 type Question struct {
-	ID       int       `json:"id"`       // Question number (1, 2, 3, ...)
-	Text     string    `json:"text"`     // The question text
-	Options  []Option  `json:"options"`  // List of answer options
-	Category string    `json:"category"` // Category/subject (e.g., "Jagdwaffen")
+	ID       int      `json:"id"`       // Question number (1, 2, 3, ...)
+	Text     string   `json:"text"`     // The question text
+	Options  []Option `json:"options"`  // List of answer options
+	Category Category `json:"category"` // Resolved Sachgebiet/sub-section this question belongs to
 }
 
 // QuestionCatalog represents the entire collection of questions
 type QuestionCatalog struct {
-	Title        string       `json:"title"`        // Title of the exam
-	Year         int          `json:"year"`         // Year of the exam
-	State        string       `json:"state"`        // State code (e.g., "by" for Bayern)
-	Subject      string       `json:"subject"`      // Main subject area
-	TotalCount   int          `json:"totalCount"`   // Total number of questions
-	Questions    []Question   `json:"questions"`    // List of all questions
-	LastModified string       `json:"lastModified"` // When this was generated
+	Title        string     `json:"title"`        // Title of the exam
+	Year         int        `json:"year"`         // Year of the exam
+	State        string     `json:"state"`        // State code (e.g., "by" for Bayern)
+	Subject      string     `json:"subject"`      // Main subject area
+	TotalCount   int        `json:"totalCount"`   // Total number of questions
+	Questions    []Question `json:"questions"`    // List of all questions
+	LastModified string     `json:"lastModified"` // When this was generated
+}
+
+// CategoriesIndex groups question IDs by their top-level Sachgebiet, keyed
+// as e.g. "SG1".
+func (c *QuestionCatalog) CategoriesIndex() map[string][]int {
+	index := make(map[string][]int)
+	for _, q := range c.Questions {
+		key := fmt.Sprintf("SG%d", q.Category.SG)
+		index[key] = append(index[key], q.ID)
+	}
+	return index
 }
@@ -0,0 +1,87 @@
+package pdf
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jagdpruefer/parser/pkg/models"
+)
+
+// categoryEntry is one level of the resolver's stack: a Sachgebiet or a
+// sub-section header, along with the breadcrumb label it contributes to
+// Category.Path.
+type categoryEntry struct {
+	label string
+	title string
+}
+
+// CategoryResolver maintains a stack of Sachgebiet / sub-section headers
+// while scanning the extracted text line by line, so each question can
+// inherit the current position in the hierarchy instead of a single raw
+// header string.
+type CategoryResolver struct {
+	sgPattern    *regexp.Regexp
+	subSGPattern *regexp.Regexp
+
+	sg    int
+	subSG string
+	stack []categoryEntry
+}
+
+// NewCategoryResolver returns a resolver with no position in the hierarchy yet.
+func NewCategoryResolver() *CategoryResolver {
+	return &CategoryResolver{
+		sgPattern:    regexp.MustCompile(`^(\d+)\.\s*Sachgebiet:\s*(.+)$`),
+		subSGPattern: regexp.MustCompile(`^(\d+\.\d+)\s+(\S.*)$`),
+	}
+}
+
+// Feed inspects a single (already trimmed) line and updates the resolver's
+// position if it's a Sachgebiet header (pushes a new top-level entry,
+// resetting the stack) or a sub-section header (pushes or replaces the
+// sub-level above the current Sachgebiet).
+func (r *CategoryResolver) Feed(line string) {
+	if m := r.sgPattern.FindStringSubmatch(line); m != nil {
+		sg, err := strconv.Atoi(m[1])
+		if err != nil {
+			return
+		}
+		title := strings.TrimSpace(m[2])
+		r.sg = sg
+		r.subSG = ""
+		r.stack = []categoryEntry{{
+			label: fmt.Sprintf("%d. Sachgebiet: %s", sg, title),
+			title: title,
+		}}
+		return
+	}
+
+	if m := r.subSGPattern.FindStringSubmatch(line); m != nil {
+		if len(r.stack) == 0 {
+			// No Sachgebiet header seen yet; nothing to attach this sub-level to.
+			return
+		}
+		sub := m[1]
+		title := strings.TrimSpace(m[2])
+		entry := categoryEntry{label: fmt.Sprintf("%s %s", sub, title), title: title}
+		if len(r.stack) > 1 {
+			r.stack[1] = entry
+		} else {
+			r.stack = append(r.stack, entry)
+		}
+		r.subSG = sub
+	}
+}
+
+// Current returns the Category that a question encountered at this point in
+// the scan should inherit.
+func (r *CategoryResolver) Current() models.Category {
+	cat := models.Category{SG: r.sg, SubSG: r.subSG}
+	for _, e := range r.stack {
+		cat.Path = append(cat.Path, e.label)
+		cat.Title = e.title
+	}
+	return cat
+}
@@ -0,0 +1,98 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	pdfreader "github.com/ledongthuc/pdf"
+)
+
+// TextExtractor converts a PDF file into its raw text content. Implementations
+// back Parser so the parsing stage never has to know whether the text came
+// from a shelled-out binary, a pure-Go decoder, or a test fixture.
+type TextExtractor interface {
+	ExtractText(pdfPath string) (string, error)
+}
+
+// pdftotextExtractor shells out to the poppler-utils pdftotext binary. This is
+// the extractor Parser has always used and remains the default, but now
+// requires pdftotext to be installed only when it's actually selected.
+type pdftotextExtractor struct {
+	layout bool
+}
+
+// ExtractText runs pdftotext against pdfPath and returns its stdout.
+func (e *pdftotextExtractor) ExtractText(pdfPath string) (string, error) {
+	args := make([]string, 0, 3)
+	if e.layout {
+		args = append(args, "-layout")
+	}
+	args = append(args, pdfPath, "-")
+
+	cmd := exec.Command("pdftotext", args...)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("pdftotext failed: %w", err)
+	}
+	return string(output), nil
+}
+
+// GoPDFExtractor extracts text with a pure-Go PDF decoder, so parsing works
+// in environments where the pdftotext binary isn't installed.
+type GoPDFExtractor struct{}
+
+// NewGoPDFExtractor returns a TextExtractor backed by a pure-Go PDF decoder.
+// Pass it to WithExtractor to avoid the pdftotext dependency entirely.
+func NewGoPDFExtractor() *GoPDFExtractor {
+	return &GoPDFExtractor{}
+}
+
+// ExtractText decodes pdfPath page by page and concatenates the plain text.
+func (e *GoPDFExtractor) ExtractText(pdfPath string) (string, error) {
+	f, r, err := pdfreader.Open(pdfPath)
+	if err != nil {
+		return "", fmt.Errorf("open pdf: %w", err)
+	}
+	defer f.Close()
+
+	var buf bytes.Buffer
+	for i := 1; i <= r.NumPage(); i++ {
+		page := r.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+		text, err := page.GetPlainText(nil)
+		if err != nil {
+			return "", fmt.Errorf("extract page %d: %w", i, err)
+		}
+		buf.WriteString(text)
+	}
+	return buf.String(), nil
+}
+
+// FixtureExtractor reads pre-dumped pdftotext output from disk instead of
+// decoding a real PDF, so tests can exercise the parsing stage without
+// shelling out or depending on a binary fixture.
+type FixtureExtractor struct {
+	// Path is the fixture .txt file to read. If empty, ExtractText derives
+	// it from pdfPath by swapping the extension to .txt.
+	Path string
+}
+
+// ExtractText returns the contents of the configured (or derived) fixture file.
+func (e *FixtureExtractor) ExtractText(pdfPath string) (string, error) {
+	path := e.Path
+	if path == "" {
+		path = strings.TrimSuffix(pdfPath, filepath.Ext(pdfPath)) + ".txt"
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read fixture %s: %w", path, err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,77 @@
+package pdf
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/jagdpruefer/parser/pkg/models"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in pkg/pdf/testdata")
+
+// TestParseGoldens feeds each testdata/<case>/input.txt through the parser
+// (via FixtureExtractor) and diffs the result against expected.json. Run
+// with -update to rewrite the golden files after an intentional parser change.
+func TestParseGoldens(t *testing.T) {
+	root := "testdata"
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		t.Fatalf("read %s: %v", root, err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		t.Run(name, func(t *testing.T) {
+			dir := filepath.Join(root, name)
+			inputPath := filepath.Join(dir, "input.txt")
+			expectedPath := filepath.Join(dir, "expected.json")
+
+			p := NewParser("unused.pdf", WithExtractor(&FixtureExtractor{Path: inputPath}))
+			got, err := p.Parse()
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+
+			gotJSON, err := json.MarshalIndent(got, "", "  ")
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			gotJSON = append(gotJSON, '\n')
+
+			if *update {
+				if err := os.WriteFile(expectedPath, gotJSON, 0644); err != nil {
+					t.Fatalf("write golden: %v", err)
+				}
+				return
+			}
+
+			wantData, err := os.ReadFile(expectedPath)
+			if err != nil {
+				t.Fatalf("read %s: %v", expectedPath, err)
+			}
+
+			var want, gotCatalog models.QuestionCatalog
+			if err := json.Unmarshal(wantData, &want); err != nil {
+				t.Fatalf("unmarshal %s: %v", expectedPath, err)
+			}
+			if err := json.Unmarshal(gotJSON, &gotCatalog); err != nil {
+				t.Fatalf("unmarshal parsed result: %v", err)
+			}
+
+			// LastModified is stamped with time.Now() on every parse, so it
+			// can never match a golden file.
+			if diff := cmp.Diff(want, gotCatalog, cmpopts.IgnoreFields(models.QuestionCatalog{}, "LastModified")); diff != "" {
+				t.Errorf("parse mismatch for %s (-want +got):\n%s", name, diff)
+			}
+		})
+	}
+}
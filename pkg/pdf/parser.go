@@ -2,7 +2,6 @@ package pdf
 
 import (
 	"fmt"
-	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
@@ -14,6 +13,7 @@ import (
 // Type aliases for convenience
 type Question = models.Question
 type Option = models.Option
+type Category = models.Category
 type QuestionCatalog = models.QuestionCatalog
 
 // CurrentTimestamp returns the current time in RFC3339 format
@@ -21,20 +21,72 @@ func CurrentTimestamp() string {
 	return time.Now().Format(time.RFC3339)
 }
 
+// SelectFunc reports whether a parsed question should be kept in the
+// catalog. Questions rejected by SelectFunc are dropped before they're added,
+// mirroring restic archiver's SelectFunc pipe-filter pattern.
+type SelectFunc func(q *models.Question) bool
+
+// SelectCategoryFunc reports whether a question's category should be kept in
+// the catalog.
+type SelectCategoryFunc func(category models.Category) bool
+
 // Parser handles PDF parsing
 type Parser struct {
-	pdfPath string
+	pdfPath    string
+	extractor  TextExtractor
+	layoutMode bool
+
+	// SelectFunc, if set, filters questions during parseText; questions for
+	// which it returns false are dropped from the catalog.
+	SelectFunc SelectFunc
+	// SelectCategoryFunc, if set, filters questions by category during
+	// parseText the same way SelectFunc filters by question.
+	SelectCategoryFunc SelectCategoryFunc
+}
+
+// ParserOption configures a Parser constructed via NewParser.
+type ParserOption func(*Parser)
+
+// WithExtractor overrides the TextExtractor used to turn the PDF into raw
+// text. Defaults to shelling out to pdftotext.
+func WithExtractor(e TextExtractor) ParserOption {
+	return func(p *Parser) { p.extractor = e }
+}
+
+// WithLayoutMode toggles pdftotext's -layout flag, which preserves column
+// layout instead of reflowing text. Only affects the default pdftotext
+// extractor; it has no effect when combined with WithExtractor.
+func WithLayoutMode(layout bool) ParserOption {
+	return func(p *Parser) { p.layoutMode = layout }
 }
 
-// NewParser creates a new PDF parser
-func NewParser(pdfPath string) *Parser {
-	return &Parser{pdfPath: pdfPath}
+// WithSelectFunc sets the Parser's SelectFunc.
+func WithSelectFunc(fn SelectFunc) ParserOption {
+	return func(p *Parser) { p.SelectFunc = fn }
+}
+
+// WithSelectCategoryFunc sets the Parser's SelectCategoryFunc.
+func WithSelectCategoryFunc(fn SelectCategoryFunc) ParserOption {
+	return func(p *Parser) { p.SelectCategoryFunc = fn }
+}
+
+// NewParser creates a new PDF parser. By default it shells out to pdftotext;
+// pass WithExtractor to use a different backend (e.g. a pure-Go decoder or a
+// fixture-based extractor for tests).
+func NewParser(pdfPath string, opts ...ParserOption) *Parser {
+	p := &Parser{pdfPath: pdfPath}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.extractor == nil {
+		p.extractor = &pdftotextExtractor{layout: p.layoutMode}
+	}
+	return p
 }
 
 // Parse extracts questions from the PDF
 func (p *Parser) Parse() (*models.QuestionCatalog, error) {
-	// Extract text from PDF using pdftotext
-	text, err := p.extractTextFromPDF()
+	text, err := p.extractor.ExtractText(p.pdfPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract text from PDF: %w", err)
 	}
@@ -48,28 +100,20 @@ func (p *Parser) Parse() (*models.QuestionCatalog, error) {
 	return catalog, nil
 }
 
-// extractTextFromPDF uses pdftotext to extract text content
-func (p *Parser) extractTextFromPDF() (string, error) {
-	cmd := exec.Command("pdftotext", p.pdfPath, "-")
-	output, err := cmd.Output()
-	if err != nil {
-		return "", fmt.Errorf("pdftotext failed: %w", err)
-	}
-	return string(output), nil
-}
-
 // Question represents a raw parsed question with its content
 type rawQuestion struct {
-	number  int
-	text    string
-	options map[string]*models.Option
+	number   int
+	text     string
+	options  map[string]*models.Option
+	category models.Category
 }
 
-func newRawQuestion(number int, text string) *rawQuestion {
+func newRawQuestion(number int, text string, category models.Category) *rawQuestion {
 	return &rawQuestion{
-		number:  number,
-		text:    text,
-		options: make(map[string]*models.Option),
+		number:   number,
+		text:     text,
+		options:  make(map[string]*models.Option),
+		category: category,
 	}
 }
 
@@ -97,10 +141,9 @@ func (p *Parser) parseText(text string) (*models.QuestionCatalog, error) {
 	// Raw questions keyed by number
 	rawQuestions := make(map[int]*rawQuestion)
 	var lastQuestionNum int
-	var currentCategory string
+	resolver := NewCategoryResolver()
 	var nextOptionIsCorrect bool // Track if next option should be marked as correct
 
-
 	// Skip header content until we find the first real question section
 	// Look for pattern like "N.M" where N and M are numbers (e.g., "1.1", "3.1", "4.2")
 	startIdx := 0
@@ -110,17 +153,17 @@ func (p *Parser) parseText(text string) (*models.QuestionCatalog, error) {
 		trimmedLine := strings.TrimSpace(lines[i])
 		if sectionHeaderPattern.MatchString(trimmedLine) {
 			startIdx = i
-			// Capture the category at this point
-			for j := i - 1; j >= 0 && j >= i-50; j-- {
-				if strings.Contains(strings.TrimSpace(lines[j]), "Sachgebiet") && strings.Contains(strings.TrimSpace(lines[j]), ":") {
-					currentCategory = strings.TrimSpace(lines[j])
-					break
-				}
-			}
 			break
 		}
 	}
 
+	// Feed every line up to and including the first section header so the
+	// resolver has already seen its Sachgebiet (and that header itself) by
+	// the time the main scan starts at startIdx.
+	for i := 0; i <= startIdx; i++ {
+		resolver.Feed(strings.TrimSpace(lines[i]))
+	}
+
 	for i := startIdx; i < len(lines); i++ {
 		trimmedLine := strings.TrimSpace(lines[i])
 
@@ -130,9 +173,7 @@ func (p *Parser) parseText(text string) (*models.QuestionCatalog, error) {
 		}
 
 		// Track category changes - look for "1. Sachgebiet:" or "1.1 Lang- und..." patterns
-		if strings.Contains(trimmedLine, "Sachgebiet") && strings.Contains(trimmedLine, ":") {
-			currentCategory = trimmedLine
-		}
+		resolver.Feed(trimmedLine)
 
 		// Skip metadata/footer lines
 		if strings.Contains(trimmedLine, "Stand:") || strings.Contains(trimmedLine, "Seite") ||
@@ -176,7 +217,7 @@ func (p *Parser) parseText(text string) (*models.QuestionCatalog, error) {
 			// Remove trailing "X a)" which is mistakenly included
 			qText = regexp.MustCompile(`\s+X\s+[a-f]\)\s*$`).ReplaceAllString(qText, "")
 			if len(qText) > 5 {
-				rawQuestions[qNum] = newRawQuestion(qNum, qText)
+				rawQuestions[qNum] = newRawQuestion(qNum, qText, resolver.Current())
 			}
 		}
 
@@ -222,7 +263,7 @@ func (p *Parser) parseText(text string) (*models.QuestionCatalog, error) {
 			qText = regexp.MustCompile(`\s+X\s+[a-f]\).*$`).ReplaceAllString(qText, "")
 			qText = strings.TrimSpace(qText)
 			if len(qText) > 5 {
-				rawQuestions[qNum] = newRawQuestion(qNum, qText)
+				rawQuestions[qNum] = newRawQuestion(qNum, qText, resolver.Current())
 			}
 		}
 
@@ -349,8 +390,16 @@ func (p *Parser) parseText(text string) (*models.QuestionCatalog, error) {
 				ID:       rq.number,
 				Text:     rq.text,
 				Options:  opts,
-				Category: currentCategory,
+				Category: rq.category,
+			}
+
+			if p.SelectFunc != nil && !p.SelectFunc(&q) {
+				continue
 			}
+			if p.SelectCategoryFunc != nil && !p.SelectCategoryFunc(q.Category) {
+				continue
+			}
+
 			catalog.Questions = append(catalog.Questions, q)
 		}
 	}
@@ -360,7 +409,7 @@ func (p *Parser) parseText(text string) (*models.QuestionCatalog, error) {
 }
 
 // ParseFile is a convenience function that takes a filename and returns the parsed catalog
-func ParseFile(filename string) (*models.QuestionCatalog, error) {
-	parser := NewParser(filename)
+func ParseFile(filename string, opts ...ParserOption) (*models.QuestionCatalog, error) {
+	parser := NewParser(filename, opts...)
 	return parser.Parse()
 }
@@ -0,0 +1,162 @@
+// Package search implements fzf-style fuzzy matching over a parsed
+// QuestionCatalog, scoring candidates the way the original fzf algorithm
+// did: shorter matched windows rank first, ties broken by candidate length.
+package search
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jagdpruefer/parser/pkg/models"
+)
+
+// Field identifies a searchable part of a question.
+type Field string
+
+const (
+	FieldText     Field = "text"
+	FieldOptions  Field = "options"
+	FieldCategory Field = "category"
+)
+
+// DefaultSortLimit is the number of matches, taken in scan order, that get
+// detailed sorting before Search falls back to insertion order.
+const DefaultSortLimit = 1000
+
+// Match is a single fuzzy match against one field of one question.
+type Match struct {
+	Question *models.Question
+	Field    Field
+	Text     string // the matched field's full text
+	Start    int    // start of the matched window within Text
+	End      int    // end (exclusive) of the matched window within Text
+}
+
+// Options configures a Search call.
+type Options struct {
+	// Fields restricts matching to the given fields. Empty means all fields.
+	Fields []Field
+	// CorrectOnly restricts matching to questions with at least one correct option.
+	CorrectOnly bool
+	// SortLimit caps how many candidates get detailed sorting; 0 uses DefaultSortLimit.
+	SortLimit int
+}
+
+// Search performs fzf-style fuzzy matching of query against catalog and
+// returns ranked matches.
+func Search(catalog *models.QuestionCatalog, query string, opts Options) []Match {
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = []Field{FieldText, FieldOptions, FieldCategory}
+	}
+	limit := opts.SortLimit
+	if limit == 0 {
+		limit = DefaultSortLimit
+	}
+
+	var matches []Match
+	for i := range catalog.Questions {
+		q := &catalog.Questions[i]
+		if opts.CorrectOnly && !hasCorrectOption(q) {
+			continue
+		}
+		for _, f := range fields {
+			matches = append(matches, matchField(q, f, query)...)
+		}
+	}
+
+	// Detailed sorting only applies up to the configured limit; beyond that
+	// matches keep their scan order, the same fallback fzf uses to stay
+	// responsive on huge result sets instead of paying for a full sort.
+	head, tail := matches, []Match(nil)
+	if len(matches) > limit {
+		head, tail = matches[:limit], matches[limit:]
+	}
+
+	sort.SliceStable(head, func(i, j int) bool {
+		wi, wj := head[i].End-head[i].Start, head[j].End-head[j].Start
+		if wi != wj {
+			return wi < wj
+		}
+		return len(head[i].Text) < len(head[j].Text)
+	})
+
+	return append(head, tail...)
+}
+
+func matchField(q *models.Question, field Field, query string) []Match {
+	switch field {
+	case FieldText:
+		if start, end, ok := fuzzyMatch(q.Text, query); ok {
+			return []Match{{Question: q, Field: field, Text: q.Text, Start: start, End: end}}
+		}
+	case FieldCategory:
+		category := strings.Join(q.Category.Path, " / ")
+		if start, end, ok := fuzzyMatch(category, query); ok {
+			return []Match{{Question: q, Field: field, Text: category, Start: start, End: end}}
+		}
+	case FieldOptions:
+		var out []Match
+		for _, opt := range q.Options {
+			if start, end, ok := fuzzyMatch(opt.Text, query); ok {
+				out = append(out, Match{Question: q, Field: field, Text: opt.Text, Start: start, End: end})
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func hasCorrectOption(q *models.Question) bool {
+	for _, opt := range q.Options {
+		if opt.Correct {
+			return true
+		}
+	}
+	return false
+}
+
+// fuzzyMatch finds the leftmost, then tightest, window in text that
+// contains every rune of query in order (case-insensitive) — the same
+// two-pass scan fzf's original (v1) algorithm uses.
+func fuzzyMatch(text, query string) (start, end int, ok bool) {
+	if query == "" {
+		return 0, 0, false
+	}
+	t := []rune(strings.ToLower(text))
+	q := []rune(strings.ToLower(query))
+
+	// Forward scan: find the leftmost position where all query runes occur in order.
+	start = -1
+	qi := 0
+	for i, c := range t {
+		if c == q[qi] {
+			if qi == 0 {
+				start = i
+			}
+			qi++
+			if qi == len(q) {
+				end = i + 1
+				break
+			}
+		}
+	}
+	if qi != len(q) {
+		return 0, 0, false
+	}
+
+	// Backward scan from the match end to shrink the window, reporting the
+	// tightest span that still contains every query rune in order.
+	qi = len(q) - 1
+	for i := end - 1; i >= start; i-- {
+		if t[i] == q[qi] {
+			qi--
+			if qi < 0 {
+				start = i
+				break
+			}
+		}
+	}
+
+	return start, end, true
+}
@@ -0,0 +1,83 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jagdpruefer/parser/pkg/models"
+)
+
+func TestFuzzyMatchNoMatch(t *testing.T) {
+	if _, _, ok := fuzzyMatch("hello world", "xyz"); ok {
+		t.Fatal("fuzzyMatch should not match when a query rune is absent from the text")
+	}
+	if _, _, ok := fuzzyMatch("short", "shorter query than text"); ok {
+		t.Fatal("fuzzyMatch should not match when the query is longer than the text")
+	}
+}
+
+func TestFuzzyMatchTightestWindow(t *testing.T) {
+	start, end, ok := fuzzyMatch("the quick brown fox", "qkf")
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if got, want := "quick brown f", "the quick brown fox"[start:end]; got != want {
+		t.Errorf("window = %q, want %q", got, want)
+	}
+}
+
+func textQuestion(id int, text string) models.Question {
+	return models.Question{ID: id, Text: text}
+}
+
+func TestSearchTieBreakByCandidateLength(t *testing.T) {
+	// Both questions match "fox" with an equal-length window (3 runes), so
+	// the tie should be broken in favor of the shorter candidate text.
+	catalog := &models.QuestionCatalog{
+		Questions: []models.Question{
+			textQuestion(1, "a very long sentence that eventually mentions a fox"),
+			textQuestion(2, "fox"),
+		},
+	}
+
+	matches := Search(catalog, "fox", Options{Fields: []Field{FieldText}})
+	if len(matches) != 2 {
+		t.Fatalf("got %d matches, want 2", len(matches))
+	}
+	if matches[0].Question.ID != 2 {
+		t.Errorf("first match is Q%d, want Q2 (shorter candidate should sort first on a tie)", matches[0].Question.ID)
+	}
+	if matches[1].Question.ID != 1 {
+		t.Errorf("second match is Q%d, want Q1", matches[1].Question.ID)
+	}
+}
+
+func TestSearchSortLimitFallback(t *testing.T) {
+	// Every question matches "e" with a 1-rune window but with shrinking text
+	// length, so without the limit they'd sort in reverse scan order. Questions
+	// beyond SortLimit should instead keep their original scan order.
+	var questions []models.Question
+	for i := 1; i <= 5; i++ {
+		questions = append(questions, textQuestion(i, "e"+strings.Repeat("x", 6-i)))
+	}
+	catalog := &models.QuestionCatalog{Questions: questions}
+
+	matches := Search(catalog, "e", Options{Fields: []Field{FieldText}, SortLimit: 2})
+	if len(matches) != 5 {
+		t.Fatalf("got %d matches, want 5", len(matches))
+	}
+
+	// The first two (the head) are sorted by window length then text length;
+	// since all windows are length 1, the shorter-text question (Q2) wins.
+	if matches[0].Question.ID != 2 {
+		t.Errorf("matches[0] is Q%d, want Q2", matches[0].Question.ID)
+	}
+
+	// The tail (everything past SortLimit) must retain scan order: Q3, Q4, Q5.
+	wantTail := []int{3, 4, 5}
+	for i, id := range wantTail {
+		if got := matches[2+i].Question.ID; got != id {
+			t.Errorf("matches[%d] is Q%d, want Q%d (tail should keep scan order)", 2+i, got, id)
+		}
+	}
+}